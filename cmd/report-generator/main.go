@@ -0,0 +1,234 @@
+// Command report-generator pulls an alliance's character data from
+// msf.pal.gg and writes power rankings for each war/raid team category to
+// one or more output destinations.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/msfgg"
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/msfpal"
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/output"
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/report"
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/sheets"
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/storage"
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/teams"
+)
+
+// config holds the settings needed to pull alliance data and write a report,
+// sourced from environment variables with flags for local overrides.
+type config struct {
+	allianceID      string
+	apiKey          string
+	spreadsheetID   string
+	credentialsPath string
+	tokenPath       string
+	snapshotDir     string
+	teamsPath       string
+	outputDir       string
+	discordWebhook  string
+	outputs         string
+	since           string
+	jobs            int
+	jobTimeout      time.Duration
+}
+
+func loadConfig() (config, error) {
+	cfg := config{
+		allianceID:      os.Getenv("MSF_ALLIANCE_ID"),
+		apiKey:          os.Getenv("MSF_PAL_API_KEY"),
+		spreadsheetID:   os.Getenv("MSF_SPREADSHEET_ID"),
+		discordWebhook:  os.Getenv("MSF_DISCORD_WEBHOOK"),
+		credentialsPath: "credentials.json",
+		tokenPath:       "token.json",
+		snapshotDir:     "data/snapshots",
+		teamsPath:       envOr("MSF_TEAMS_FILE", "teams.yaml"),
+		outputDir:       "reports",
+		outputs:         "sheets",
+		jobs:            runtime.NumCPU(),
+		jobTimeout:      30 * time.Second,
+	}
+
+	flag.StringVar(&cfg.allianceID, "alliance-id", cfg.allianceID, "MSF alliance ID (or MSF_ALLIANCE_ID)")
+	flag.StringVar(&cfg.apiKey, "api-key", cfg.apiKey, "msf.pal.gg API key (or MSF_PAL_API_KEY)")
+	flag.StringVar(&cfg.spreadsheetID, "spreadsheet-id", cfg.spreadsheetID, "destination Google Sheets ID, required if -output includes sheets (or MSF_SPREADSHEET_ID)")
+	flag.StringVar(&cfg.credentialsPath, "credentials", cfg.credentialsPath, "path to the Google OAuth client credentials file")
+	flag.StringVar(&cfg.tokenPath, "token", cfg.tokenPath, "path to the cached Google OAuth token file")
+	flag.StringVar(&cfg.snapshotDir, "snapshot-dir", cfg.snapshotDir, "directory alliance snapshots are persisted to")
+	flag.StringVar(&cfg.teamsPath, "teams-file", cfg.teamsPath, "path to the YAML file defining war/raid teams (or MSF_TEAMS_FILE)")
+	flag.StringVar(&cfg.outputDir, "output-dir", cfg.outputDir, "directory csv/json/markdown reports are written to")
+	flag.StringVar(&cfg.discordWebhook, "discord-webhook", cfg.discordWebhook, "Discord webhook URL, required if -output includes discord (or MSF_DISCORD_WEBHOOK)")
+	flag.StringVar(&cfg.outputs, "output", cfg.outputs, "comma-separated output destinations: sheets, csv, json, markdown, discord")
+	flag.StringVar(&cfg.since, "since", "", "snapshot ID or RFC3339 timestamp to diff the current pull against, written to a Delta report")
+	flag.IntVar(&cfg.jobs, "jobs", cfg.jobs, "number of reports to generate concurrently")
+	flag.DurationVar(&cfg.jobTimeout, "job-timeout", cfg.jobTimeout, "per-report generation timeout")
+	flag.Parse()
+
+	if cfg.allianceID == "" {
+		return config{}, fmt.Errorf("alliance ID is required (set -alliance-id or MSF_ALLIANCE_ID)")
+	}
+
+	if cfg.apiKey == "" {
+		return config{}, fmt.Errorf("msf.pal.gg API key is required (set -api-key or MSF_PAL_API_KEY)")
+	}
+
+	for _, kind := range strings.Split(cfg.outputs, ",") {
+		switch strings.TrimSpace(kind) {
+		case "sheets":
+			if cfg.spreadsheetID == "" {
+				return config{}, fmt.Errorf("spreadsheet ID is required for -output=sheets (set -spreadsheet-id or MSF_SPREADSHEET_ID)")
+			}
+		case "discord":
+			if cfg.discordWebhook == "" {
+				return config{}, fmt.Errorf("discord webhook URL is required for -output=discord (set -discord-webhook or MSF_DISCORD_WEBHOOK)")
+			}
+		case "csv", "json", "markdown":
+		default:
+			return config{}, fmt.Errorf("unknown -output destination %q", kind)
+		}
+	}
+
+	return cfg, nil
+}
+
+// envOr returns the named environment variable, or fallback if it is unset.
+func envOr(name, fallback string) string {
+	if v := os.Getenv(name); v != "" {
+		return v
+	}
+
+	return fallback
+}
+
+// outputWriters builds the output.Writer for each destination named in
+// cfg.outputs.
+func outputWriters(cfg config) []output.Writer {
+	var writers []output.Writer
+
+	for _, kind := range strings.Split(cfg.outputs, ",") {
+		switch strings.TrimSpace(kind) {
+		case "sheets":
+			writer := sheets.NewWriter(cfg.spreadsheetID, cfg.credentialsPath, cfg.tokenPath)
+			writers = append(writers, output.NewSheetsWriter(writer))
+		case "csv":
+			writers = append(writers, output.NewCSVWriter(cfg.outputDir))
+		case "json":
+			writers = append(writers, output.NewJSONWriter(cfg.outputDir))
+		case "markdown":
+			writers = append(writers, output.NewMarkdownWriter(cfg.outputDir))
+		case "discord":
+			writers = append(writers, output.NewDiscordWebhookWriter(cfg.discordWebhook, 0))
+		}
+	}
+
+	return writers
+}
+
+func main() {
+	cfg, err := loadConfig()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	ctx := context.Background()
+
+	client := msfpal.NewClient(cfg.apiKey)
+
+	characters, err := client.AllianceCharacters(ctx, cfg.allianceID)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	store, err := storage.New(cfg.snapshotDir)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	snapshot, err := store.Save(characters)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	catalog, err := msfgg.NewClient().CharacterCatalog(ctx)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	teamsStore, err := teams.NewStore(cfg.teamsPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	teamsStore.SetCatalog(catalog)
+
+	if err := teamsStore.Validate(catalog); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	playerCharactersMap := report.PlayerCharactersByPlayer(snapshot.Characters)
+
+	categories := teamsStore.Categories()
+
+	categoryNames := make([]string, 0, len(categories))
+	for category := range categories {
+		categoryNames = append(categoryNames, category)
+	}
+
+	sort.Strings(categoryNames)
+
+	jobs := make([]report.GenJob, 0, len(categoryNames)+1)
+
+	for _, category := range categoryNames {
+		categoryTeams := categories[category]
+		sheetName := teams.SheetName(category)
+
+		jobs = append(jobs, report.SyncJob(sheetName, func() output.Report {
+			return report.AverageTeamPowerByPlayerWithSynergy(playerCharactersMap, categoryTeams, sheetName, catalog)
+		}))
+	}
+
+	if cfg.since != "" {
+		sinceSnapshot, err := store.Find(cfg.since)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		delta := store.Diff(sinceSnapshot, snapshot)
+
+		jobs = append(jobs, report.SyncJob("Delta", func() output.Report {
+			return report.DeltaTeamPowerByPlayer(delta, teamsStore.AllTeams(), "Delta")
+		}))
+	}
+
+	scheduler := report.NewScheduler(cfg.jobs, cfg.jobTimeout)
+
+	results, err := scheduler.Run(ctx, jobs)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	writers := outputWriters(cfg)
+
+	for _, writer := range writers {
+		if err := output.WriteAll(ctx, writer, results); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+}
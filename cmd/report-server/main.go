@@ -0,0 +1,173 @@
+// Command report-server runs report-generator as a long-running HTTP
+// service, exposing endpoints a Discord bot or dashboard can call instead of
+// invoking the CLI directly.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/msfgg"
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/msfpal"
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/output"
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/report"
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/server"
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/sheets"
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/storage"
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/teams"
+)
+
+type config struct {
+	allianceID      string
+	apiKey          string
+	spreadsheetID   string
+	credentialsPath string
+	tokenPath       string
+	snapshotDir     string
+	teamsPath       string
+	tokensDir       string
+	addr            string
+	jobs            int
+	jobTimeout      time.Duration
+}
+
+func loadConfig() (config, error) {
+	cfg := config{
+		allianceID:      os.Getenv("MSF_ALLIANCE_ID"),
+		apiKey:          os.Getenv("MSF_PAL_API_KEY"),
+		spreadsheetID:   os.Getenv("MSF_SPREADSHEET_ID"),
+		credentialsPath: "credentials.json",
+		tokenPath:       "token.json",
+		snapshotDir:     "data/snapshots",
+		teamsPath:       envOr("MSF_TEAMS_FILE", "teams.yaml"),
+		tokensDir:       "tokens",
+		addr:            ":8080",
+		jobs:            runtime.NumCPU(),
+		jobTimeout:      30 * time.Second,
+	}
+
+	flag.StringVar(&cfg.allianceID, "alliance-id", cfg.allianceID, "MSF alliance ID (or MSF_ALLIANCE_ID)")
+	flag.StringVar(&cfg.apiKey, "api-key", cfg.apiKey, "msf.pal.gg API key (or MSF_PAL_API_KEY)")
+	flag.StringVar(&cfg.spreadsheetID, "spreadsheet-id", cfg.spreadsheetID, "destination Google Sheets ID (or MSF_SPREADSHEET_ID)")
+	flag.StringVar(&cfg.credentialsPath, "credentials", cfg.credentialsPath, "path to the Google OAuth client credentials file")
+	flag.StringVar(&cfg.tokenPath, "token", cfg.tokenPath, "path to the cached Google OAuth token file")
+	flag.StringVar(&cfg.snapshotDir, "snapshot-dir", cfg.snapshotDir, "directory alliance snapshots are persisted to")
+	flag.StringVar(&cfg.teamsPath, "teams-file", cfg.teamsPath, "path to the YAML file defining war/raid teams (or MSF_TEAMS_FILE)")
+	flag.StringVar(&cfg.tokensDir, "tokens-dir", cfg.tokensDir, "directory of bearer token files allowed to call this server")
+	flag.StringVar(&cfg.addr, "addr", cfg.addr, "address to listen on")
+	flag.IntVar(&cfg.jobs, "jobs", cfg.jobs, "number of reports to generate concurrently during a full resync")
+	flag.DurationVar(&cfg.jobTimeout, "job-timeout", cfg.jobTimeout, "per-report generation timeout during a full resync")
+	flag.Parse()
+
+	if cfg.allianceID == "" {
+		return config{}, fmt.Errorf("alliance ID is required (set -alliance-id or MSF_ALLIANCE_ID)")
+	}
+
+	if cfg.apiKey == "" {
+		return config{}, fmt.Errorf("msf.pal.gg API key is required (set -api-key or MSF_PAL_API_KEY)")
+	}
+
+	if cfg.spreadsheetID == "" {
+		return config{}, fmt.Errorf("spreadsheet ID is required (set -spreadsheet-id or MSF_SPREADSHEET_ID)")
+	}
+
+	return cfg, nil
+}
+
+// envOr returns the named environment variable, or fallback if it is unset.
+func envOr(name, fallback string) string {
+	if v := os.Getenv(name); v != "" {
+		return v
+	}
+
+	return fallback
+}
+
+func main() {
+	cfg, err := loadConfig()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	tokens, err := server.LoadTokens(cfg.tokensDir)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if len(tokens) == 0 {
+		fmt.Fprintf(os.Stderr, "no bearer tokens found in %s; every request will be rejected\n", cfg.tokensDir)
+	}
+
+	client := msfpal.NewClient(cfg.apiKey)
+
+	store, err := storage.New(cfg.snapshotDir)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	ctx := context.Background()
+
+	catalog, err := msfgg.NewClient().CharacterCatalog(ctx)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	teamsStore, err := teams.NewStore(cfg.teamsPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	teamsStore.SetCatalog(catalog)
+
+	if err := teamsStore.Validate(catalog); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	go func() {
+		if err := teamsStore.Watch(ctx); err != nil {
+			log.Printf("report-server: teams file watcher stopped: %v", err)
+		}
+	}()
+
+	writer := output.NewSheetsWriter(sheets.NewWriter(cfg.spreadsheetID, cfg.credentialsPath, cfg.tokenPath))
+	scheduler := report.NewScheduler(cfg.jobs, cfg.jobTimeout)
+
+	srv := server.New(client, store, writer, scheduler, cfg.allianceID, teamsStore, catalog)
+
+	handler := requireBearerToken(tokens, srv.Routes())
+
+	log.Printf("report-server: listening on %s", cfg.addr)
+
+	if err := http.ListenAndServe(cfg.addr, handler); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// requireBearerToken rejects any request that doesn't present one of the
+// configured bearer tokens in its Authorization header.
+func requireBearerToken(tokens map[string]struct{}, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+
+		if _, ok := tokens[token]; token == "" || !ok {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
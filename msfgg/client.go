@@ -0,0 +1,80 @@
+// Package msfgg is a client for the community-maintained msf.gg character
+// catalog, used to validate character IDs and pull trait/synergy data that
+// msf.pal.gg does not expose.
+package msfgg
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/msfpal"
+)
+
+const defaultBaseURL = "https://msf.gg"
+
+// Client is a wrapper around the msf.gg public API.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithHTTPClient overrides the http.Client used to make requests, e.g. for tests.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) { c.httpClient = httpClient }
+}
+
+// WithBaseURL overrides the base URL of the msf.gg API, e.g. for tests.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) { c.baseURL = baseURL }
+}
+
+// NewClient returns a Client for the msf.gg API.
+func NewClient(opts ...Option) *Client {
+	c := &Client{
+		baseURL:    defaultBaseURL,
+		httpClient: http.DefaultClient,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// CharacterCatalog returns the full set of known MSF characters, including
+// their traits and synergy requirements.
+func (c *Client) CharacterCatalog(ctx context.Context) (msfpal.MSFCharacters, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/api/characters", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("msfgg: fetching character catalog: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("msfgg: character catalog request failed with status %d: %s", resp.StatusCode, body)
+	}
+
+	var characters msfpal.MSFCharacters
+	if err := json.Unmarshal(body, &characters); err != nil {
+		return nil, fmt.Errorf("msfgg: decoding character catalog: %w", err)
+	}
+
+	return characters, nil
+}
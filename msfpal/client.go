@@ -0,0 +1,178 @@
+package msfpal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const defaultBaseURL = "https://msf.pal.gg"
+
+// Client is a wrapper around the msf.pal.gg REST API.
+type Client struct {
+	baseURL    string
+	apiKey     string
+	httpClient *http.Client
+
+	maxRetries int
+	retryWait  time.Duration
+}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithHTTPClient overrides the http.Client used to make requests, e.g. for tests.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) { c.httpClient = httpClient }
+}
+
+// WithBaseURL overrides the base URL of the msf.pal.gg API, e.g. for tests.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) { c.baseURL = baseURL }
+}
+
+// WithRetries overrides how many times a request is retried after a
+// transient failure (a 429 or a 5xx) and how long to wait between attempts.
+func WithRetries(maxRetries int, wait time.Duration) Option {
+	return func(c *Client) {
+		c.maxRetries = maxRetries
+		c.retryWait = wait
+	}
+}
+
+// NewClient returns a Client for the msf.pal.gg API authenticated with apiKey.
+func NewClient(apiKey string, opts ...Option) *Client {
+	c := &Client{
+		baseURL:    defaultBaseURL,
+		apiKey:     apiKey,
+		httpClient: http.DefaultClient,
+		maxRetries: 3,
+		retryWait:  time.Second,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// AllianceCharacters returns every player character belonging to the given
+// alliance, paging through the results until the API reports no more pages.
+func (c *Client) AllianceCharacters(ctx context.Context, allianceID string) (MSFPlayerCharacters, error) {
+	var all MSFPlayerCharacters
+
+	page := 1
+	for {
+		var characters MSFPlayerCharacters
+
+		next, err := c.getPage(ctx, fmt.Sprintf("/rest/v1/alliance/%s/characters", allianceID), page, &characters)
+		if err != nil {
+			return nil, fmt.Errorf("msfpal: alliance characters for %q: %w", allianceID, err)
+		}
+
+		all = append(all, characters...)
+
+		if !next {
+			break
+		}
+
+		page++
+	}
+
+	return all, nil
+}
+
+// Player returns the characters owned by a single player.
+func (c *Client) Player(ctx context.Context, playerID string) (MSFPlayerCharacters, error) {
+	var characters MSFPlayerCharacters
+
+	if _, err := c.getPage(ctx, fmt.Sprintf("/rest/v1/player/%s/characters", playerID), 1, &characters); err != nil {
+		return nil, fmt.Errorf("msfpal: player characters for %q: %w", playerID, err)
+	}
+
+	return characters, nil
+}
+
+// getPage fetches a single page of results into v and reports whether
+// another page follows, via the X-Next-Page response header.
+func (c *Client) getPage(ctx context.Context, path string, page int, v interface{}) (hasNext bool, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return false, err
+	}
+
+	req.Header.Set("api-key", c.apiKey)
+
+	q := req.URL.Query()
+	q.Set("page", strconv.Itoa(page))
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := c.doWithRetries(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return false, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return false, &NotFoundError{Resource: "resource", ID: path}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return false, &APIError{StatusCode: resp.StatusCode, Body: string(body)}
+	}
+
+	if err := json.Unmarshal(body, v); err != nil {
+		return false, fmt.Errorf("decoding response: %w", err)
+	}
+
+	return resp.Header.Get("X-Next-Page") != "", nil
+}
+
+// doWithRetries performs req, retrying on rate limiting and server errors up
+// to c.maxRetries times with a fixed backoff between attempts.
+func (c *Client) doWithRetries(req *http.Request) (*http.Response, error) {
+	var lastErr error
+
+	for attempt := 0; attempt <= c.maxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-req.Context().Done():
+				return nil, req.Context().Err()
+			case <-time.After(c.retryWait):
+			}
+		}
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests {
+			retryAfter, _ := strconv.Atoi(resp.Header.Get("Retry-After"))
+			resp.Body.Close()
+			lastErr = &RateLimitError{RetryAfterSeconds: retryAfter}
+			continue
+		}
+
+		if resp.StatusCode >= 500 {
+			resp.Body.Close()
+			lastErr = &APIError{StatusCode: resp.StatusCode}
+			continue
+		}
+
+		return resp, nil
+	}
+
+	return nil, fmt.Errorf("msfpal: giving up after %d attempts: %w", c.maxRetries+1, lastErr)
+}
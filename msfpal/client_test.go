@@ -0,0 +1,69 @@
+package msfpal
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestAllianceCharacters(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("api-key"); got != "test-key" {
+			t.Errorf("api-key header = %q, want %q", got, "test-key")
+		}
+
+		w.Write([]byte(`[{"id":"thanos","player":"p1","power":100}]`))
+	}))
+	defer srv.Close()
+
+	c := NewClient("test-key", WithBaseURL(srv.URL))
+
+	got, err := c.AllianceCharacters(context.Background(), "alliance-1")
+	if err != nil {
+		t.Fatalf("AllianceCharacters() error = %v", err)
+	}
+
+	if len(got) != 1 || got[0].ID != "thanos" {
+		t.Fatalf("AllianceCharacters() = %+v, want one character %q", got, "thanos")
+	}
+}
+
+func TestAllianceCharactersNotFound(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c := NewClient("test-key", WithBaseURL(srv.URL))
+
+	if _, err := c.AllianceCharacters(context.Background(), "missing"); err == nil {
+		t.Fatal("AllianceCharacters() error = nil, want not found error")
+	}
+}
+
+func TestAllianceCharactersRetriesOnRateLimit(t *testing.T) {
+	attempts := 0
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 2 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		w.Write([]byte(`[]`))
+	}))
+	defer srv.Close()
+
+	c := NewClient("test-key", WithBaseURL(srv.URL), WithRetries(2, time.Millisecond))
+
+	if _, err := c.AllianceCharacters(context.Background(), "alliance-1"); err != nil {
+		t.Fatalf("AllianceCharacters() error = %v", err)
+	}
+
+	if attempts != 2 {
+		t.Fatalf("attempts = %d, want 2", attempts)
+	}
+}
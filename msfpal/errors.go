@@ -0,0 +1,33 @@
+package msfpal
+
+import "fmt"
+
+// APIError is returned when msf.pal.gg responds with a non-2xx status code.
+type APIError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("msfpal: request failed with status %d: %s", e.StatusCode, e.Body)
+}
+
+// RateLimitError is returned when msf.pal.gg responds with a 429 and
+// indicates how long the caller should wait before retrying again.
+type RateLimitError struct {
+	RetryAfterSeconds int
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("msfpal: rate limited, retry after %ds", e.RetryAfterSeconds)
+}
+
+// NotFoundError is returned when the requested alliance or player does not exist.
+type NotFoundError struct {
+	Resource string
+	ID       string
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("msfpal: %s %q not found", e.Resource, e.ID)
+}
@@ -0,0 +1,52 @@
+// Package msfpal provides the domain models and API client for the
+// msf.pal.gg alliance-data service.
+package msfpal
+
+// MSFCharacter contains the details of a character in MSF.
+type MSFCharacter struct {
+	ID        string `json:"id"`
+	MsfGgID   string `json:"msf.gg.id"`
+	MsfGgName string `json:"msf.gg.name"`
+	Avatar    string `json:"avatar"`
+	Labels    struct {
+		En string `json:"en"`
+		Fr string `json:"fr"`
+	} `json:"labels"`
+	Traits     []string `json:"traits"`
+	BlitzRoles []string `json:"blitzRoles,omitempty"`
+	Speed      int      `json:"speed"`
+	Synergies  []struct {
+		Capacity string `json:"capacity"`
+		Min      int    `json:"min"`
+	} `json:"synergies,omitempty"`
+}
+
+// MSFCharacters is a list of MSF characters.
+type MSFCharacters []MSFCharacter
+
+// MSFPlayerCharacter contains the current state of a player's MSF character.
+type MSFPlayerCharacter struct {
+	Basic       int    `json:"basic"`
+	Favorite    bool   `json:"favorite"`
+	GearLevel   int    `json:"gearLevel"`
+	ID          string `json:"id"`
+	Level       int    `json:"level"`
+	Passive     int    `json:"passive"`
+	Player      string `json:"player"`
+	Power       int    `json:"power"`
+	RedStars    int    `json:"redStars"`
+	Special     int    `json:"special"`
+	Ultimate    int    `json:"ultimate"`
+	Unlocked    bool   `json:"unlocked"`
+	YellowStars int    `json:"yellowStars"`
+}
+
+// MSFPlayerCharacters is a list containing a player's MSF characters.
+type MSFPlayerCharacters []MSFPlayerCharacter
+
+// MSFTeam defines details of a MSF team and the characters in them.
+type MSFTeam struct {
+	Name       string
+	Label      string
+	Characters []string
+}
@@ -0,0 +1,78 @@
+package output
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// CSVWriter writes each Report to its own CSV file inside dir, named
+// "<report name>.csv".
+type CSVWriter struct {
+	dir string
+}
+
+// NewCSVWriter returns a CSVWriter that writes reports into dir, creating it
+// if necessary.
+func NewCSVWriter(dir string) *CSVWriter {
+	return &CSVWriter{dir: dir}
+}
+
+func (w *CSVWriter) Write(ctx context.Context, report Report) error {
+	if err := os.MkdirAll(w.dir, 0o755); err != nil {
+		return fmt.Errorf("output: creating %s: %w", w.dir, err)
+	}
+
+	path := filepath.Join(w.dir, report.Name+".csv")
+
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("output: creating %s: %w", path, err)
+	}
+	defer f.Close()
+
+	cw := csv.NewWriter(f)
+
+	header := append([]string{"Player"}, report.Teams...)
+	header = append(header, "Average")
+
+	if err := cw.Write(header); err != nil {
+		return fmt.Errorf("output: writing %s: %w", path, err)
+	}
+
+	for _, row := range report.Rows {
+		record := make([]string, 0, len(header))
+		record = append(record, row.PlayerName)
+
+		for _, team := range report.Teams {
+			record = append(record, formatCell(row.TeamPowers[team], row.SynergyUnmet[team]))
+		}
+
+		record = append(record, strconv.Itoa(row.Average))
+
+		if err := cw.Write(record); err != nil {
+			return fmt.Errorf("output: writing %s: %w", path, err)
+		}
+	}
+
+	cw.Flush()
+
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf("output: writing %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// formatCell renders a team's power for a player, flagging an unmet synergy
+// requirement so it's visible outside of a spreadsheet's cell highlighting.
+func formatCell(power int, synergyUnmet bool) string {
+	if synergyUnmet {
+		return fmt.Sprintf("%d (unmet synergy)", power)
+	}
+
+	return strconv.Itoa(power)
+}
@@ -0,0 +1,54 @@
+package output
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func testReport() Report {
+	return Report{
+		Name:  "Offense",
+		Teams: []string{"Team A", "Team B"},
+		Rows: []Row{
+			{
+				PlayerName:   "alice",
+				TeamPowers:   map[string]int{"Team A": 100, "Team B": 200},
+				SynergyUnmet: map[string]bool{"Team B": true},
+				Average:      150,
+			},
+			{
+				PlayerName: "bob",
+				TeamPowers: map[string]int{"Team A": 50, "Team B": 60},
+				Average:    55,
+			},
+		},
+	}
+}
+
+func TestCSVWriterWrite(t *testing.T) {
+	dir := t.TempDir()
+
+	w := NewCSVWriter(dir)
+
+	if err := w.Write(context.Background(), testReport()); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "Offense.csv"))
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+
+	content := string(data)
+
+	if !strings.Contains(content, "Player,Team A,Team B,Average") {
+		t.Errorf("csv missing header, got:\n%s", content)
+	}
+
+	if !strings.Contains(content, "200 (unmet synergy)") {
+		t.Errorf("csv missing unmet synergy marker, got:\n%s", content)
+	}
+}
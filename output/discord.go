@@ -0,0 +1,86 @@
+package output
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// defaultTopN is how many players a DiscordWebhookWriter summarizes when
+// not configured otherwise.
+const defaultTopN = 5
+
+// DiscordWebhookWriter posts a formatted embed summarizing a Report's top
+// players to a Discord webhook.
+type DiscordWebhookWriter struct {
+	webhookURL string
+	topN       int
+	httpClient *http.Client
+}
+
+// NewDiscordWebhookWriter returns a DiscordWebhookWriter that posts to
+// webhookURL, summarizing the top topN players per report. topN below 1 is
+// treated as defaultTopN.
+func NewDiscordWebhookWriter(webhookURL string, topN int) *DiscordWebhookWriter {
+	if topN < 1 {
+		topN = defaultTopN
+	}
+
+	return &DiscordWebhookWriter{webhookURL: webhookURL, topN: topN, httpClient: http.DefaultClient}
+}
+
+type discordEmbed struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+type discordPayload struct {
+	Embeds []discordEmbed `json:"embeds"`
+}
+
+func (w *DiscordWebhookWriter) Write(ctx context.Context, report Report) error {
+	topN := w.topN
+	if topN > len(report.Rows) {
+		topN = len(report.Rows)
+	}
+
+	lines := make([]string, topN)
+	for i := 0; i < topN; i++ {
+		row := report.Rows[i]
+		lines[i] = fmt.Sprintf("%d. %s — %d", i+1, row.PlayerName, row.Average)
+	}
+
+	payload := discordPayload{
+		Embeds: []discordEmbed{{
+			Title:       report.Name,
+			Description: strings.Join(lines, "\n"),
+		}},
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("output: encoding discord payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("output: posting to discord webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("output: discord webhook returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
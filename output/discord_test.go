@@ -0,0 +1,53 @@
+package output
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDiscordWebhookWriterWrite(t *testing.T) {
+	var received discordPayload
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Fatalf("decoding request body: %v", err)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	w := NewDiscordWebhookWriter(srv.URL, 1)
+
+	if err := w.Write(context.Background(), testReport()); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	if len(received.Embeds) != 1 {
+		t.Fatalf("len(Embeds) = %d, want 1", len(received.Embeds))
+	}
+
+	if received.Embeds[0].Title != "Offense" {
+		t.Errorf("Embeds[0].Title = %q, want %q", received.Embeds[0].Title, "Offense")
+	}
+
+	if got, want := received.Embeds[0].Description, "1. alice — 150"; got != want {
+		t.Errorf("Embeds[0].Description = %q, want %q", got, want)
+	}
+}
+
+func TestDiscordWebhookWriterWriteError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "boom", http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	w := NewDiscordWebhookWriter(srv.URL, 1)
+
+	if err := w.Write(context.Background(), testReport()); err == nil {
+		t.Error("Write() error = nil, want error for non-2xx response")
+	}
+}
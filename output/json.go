@@ -0,0 +1,40 @@
+package output
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// JSONWriter writes each Report as an indented JSON file inside dir, named
+// "<report name>.json".
+type JSONWriter struct {
+	dir string
+}
+
+// NewJSONWriter returns a JSONWriter that writes reports into dir, creating
+// it if necessary.
+func NewJSONWriter(dir string) *JSONWriter {
+	return &JSONWriter{dir: dir}
+}
+
+func (w *JSONWriter) Write(ctx context.Context, report Report) error {
+	if err := os.MkdirAll(w.dir, 0o755); err != nil {
+		return fmt.Errorf("output: creating %s: %w", w.dir, err)
+	}
+
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return fmt.Errorf("output: marshaling %s: %w", report.Name, err)
+	}
+
+	path := filepath.Join(w.dir, report.Name+".json")
+
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("output: writing %s: %w", path, err)
+	}
+
+	return nil
+}
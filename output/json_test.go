@@ -0,0 +1,33 @@
+package output
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestJSONWriterWrite(t *testing.T) {
+	dir := t.TempDir()
+
+	w := NewJSONWriter(dir)
+
+	if err := w.Write(context.Background(), testReport()); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "Offense.json"))
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+
+	var got Report
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	if got.Name != "Offense" || len(got.Rows) != 2 {
+		t.Errorf("got = %+v, want name Offense with 2 rows", got)
+	}
+}
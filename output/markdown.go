@@ -0,0 +1,58 @@
+package output
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// MarkdownWriter writes each Report as a GitHub-flavored Markdown table
+// inside dir, named "<report name>.md", suitable for pasting into a gist or
+// PR description.
+type MarkdownWriter struct {
+	dir string
+}
+
+// NewMarkdownWriter returns a MarkdownWriter that writes reports into dir,
+// creating it if necessary.
+func NewMarkdownWriter(dir string) *MarkdownWriter {
+	return &MarkdownWriter{dir: dir}
+}
+
+func (w *MarkdownWriter) Write(ctx context.Context, report Report) error {
+	if err := os.MkdirAll(w.dir, 0o755); err != nil {
+		return fmt.Errorf("output: creating %s: %w", w.dir, err)
+	}
+
+	header := append([]string{"Player"}, report.Teams...)
+	header = append(header, "Average")
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# %s\n\n", report.Name)
+	fmt.Fprintf(&b, "| %s |\n", strings.Join(header, " | "))
+	fmt.Fprintf(&b, "|%s\n", strings.Repeat(" --- |", len(header)))
+
+	for _, row := range report.Rows {
+		cells := make([]string, 0, len(header))
+		cells = append(cells, row.PlayerName)
+
+		for _, team := range report.Teams {
+			cells = append(cells, formatCell(row.TeamPowers[team], row.SynergyUnmet[team]))
+		}
+
+		cells = append(cells, fmt.Sprintf("%d", row.Average))
+
+		fmt.Fprintf(&b, "| %s |\n", strings.Join(cells, " | "))
+	}
+
+	path := filepath.Join(w.dir, report.Name+".md")
+
+	if err := os.WriteFile(path, []byte(b.String()), 0o644); err != nil {
+		return fmt.Errorf("output: writing %s: %w", path, err)
+	}
+
+	return nil
+}
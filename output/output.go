@@ -0,0 +1,54 @@
+// Package output defines the common representation of a generated report
+// and the Writer interface every destination implements, so report
+// generation doesn't need to know about any particular destination's API.
+package output
+
+import "context"
+
+// Report is a single generated report, independent of any destination
+// format.
+type Report struct {
+	Name  string
+	Teams []string
+	Rows  []Row
+}
+
+// Row is one player's power across every team in a Report.
+type Row struct {
+	PlayerName   string
+	TeamPowers   map[string]int
+	SynergyUnmet map[string]bool
+	Average      int
+}
+
+// Writer writes a Report to a destination — a spreadsheet, a file, a
+// Discord channel.
+type Writer interface {
+	Write(ctx context.Context, report Report) error
+}
+
+// BatchWriter is implemented by a Writer that can write several Reports to
+// its destination in a single call. SheetsWriter implements it so a full
+// resync writes one spreadsheets.values.batchUpdate instead of one
+// spreadsheets.values.update per report, keeping multi-category runs well
+// under the Sheets API's per-minute quota.
+type BatchWriter interface {
+	WriteAll(ctx context.Context, reports []Report) error
+}
+
+// WriteAll writes every report to writer. If writer implements BatchWriter,
+// all reports are written in a single batched call; otherwise they are
+// written one at a time, in order.
+func WriteAll(ctx context.Context, writer Writer, reports []Report) error {
+	if batch, ok := writer.(BatchWriter); ok {
+		return batch.WriteAll(ctx, reports)
+	}
+
+	for _, report := range reports {
+		if err := writer.Write(ctx, report); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package output
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// writeOnlyWriter implements Writer but not BatchWriter, recording each
+// individual Write call.
+type writeOnlyWriter struct {
+	written []Report
+	err     error
+}
+
+func (w *writeOnlyWriter) Write(ctx context.Context, report Report) error {
+	if w.err != nil {
+		return w.err
+	}
+
+	w.written = append(w.written, report)
+
+	return nil
+}
+
+// batchWriter implements BatchWriter, recording whether WriteAll was called
+// as a single batch.
+type batchWriter struct {
+	batches [][]Report
+}
+
+func (w *batchWriter) Write(ctx context.Context, report Report) error {
+	return w.WriteAll(ctx, []Report{report})
+}
+
+func (w *batchWriter) WriteAll(ctx context.Context, reports []Report) error {
+	w.batches = append(w.batches, reports)
+
+	return nil
+}
+
+func TestWriteAllFallsBackToWritePerReport(t *testing.T) {
+	w := &writeOnlyWriter{}
+	reports := []Report{{Name: "A"}, {Name: "B"}}
+
+	if err := WriteAll(context.Background(), w, reports); err != nil {
+		t.Fatalf("WriteAll() error = %v", err)
+	}
+
+	if len(w.written) != 2 {
+		t.Fatalf("written = %+v, want 2 individual writes", w.written)
+	}
+}
+
+func TestWriteAllUsesBatchWriter(t *testing.T) {
+	w := &batchWriter{}
+	reports := []Report{{Name: "A"}, {Name: "B"}}
+
+	if err := WriteAll(context.Background(), w, reports); err != nil {
+		t.Fatalf("WriteAll() error = %v", err)
+	}
+
+	if len(w.batches) != 1 || len(w.batches[0]) != 2 {
+		t.Fatalf("batches = %+v, want a single batch of 2 reports", w.batches)
+	}
+}
+
+func TestWriteAllPropagatesError(t *testing.T) {
+	wantErr := errors.New("boom")
+	w := &writeOnlyWriter{err: wantErr}
+
+	if err := WriteAll(context.Background(), w, []Report{{Name: "A"}}); !errors.Is(err, wantErr) {
+		t.Fatalf("WriteAll() error = %v, want %v", err, wantErr)
+	}
+}
@@ -0,0 +1,101 @@
+package output
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/sheets"
+	gsheets "google.golang.org/api/sheets/v4"
+)
+
+var sheetCellIndexToLetter = []string{"A", "B", "C", "D", "E", "F", "G", "H", "I", "J", "K", "L", "M", "N", "O", "P", "Q", "R", "S", "T", "U", "V", "W", "X", "Y", "Z"}
+
+// SheetsWriter writes a Report to the tab of a Google Sheet named after the
+// report, highlighting any cell with an unmet synergy requirement.
+type SheetsWriter struct {
+	writer *sheets.Writer
+}
+
+// NewSheetsWriter returns a SheetsWriter that writes through writer.
+func NewSheetsWriter(writer *sheets.Writer) *SheetsWriter {
+	return &SheetsWriter{writer: writer}
+}
+
+// Write writes a single report to its own tab, as a WriteAll of one.
+func (w *SheetsWriter) Write(ctx context.Context, report Report) error {
+	return w.WriteAll(ctx, []Report{report})
+}
+
+// WriteAll writes every report to its own tab in a single
+// spreadsheets.values.batchUpdate call, instead of one API call per report,
+// then highlights any cell with an unmet synergy requirement.
+func (w *SheetsWriter) WriteAll(ctx context.Context, reports []Report) error {
+	items := make([]sheets.BatchItem, len(reports))
+	highlights := make(map[string][]sheets.CellRef, len(reports))
+
+	for i, report := range reports {
+		writeRange, valueRange, cells := sheetRange(report)
+
+		items[i] = sheets.BatchItem{WriteRange: writeRange, ValueRange: valueRange}
+
+		if len(cells) > 0 {
+			highlights[report.Name] = cells
+		}
+	}
+
+	if err := w.writer.BatchUpdate(items); err != nil {
+		return err
+	}
+
+	for _, report := range reports {
+		cells, ok := highlights[report.Name]
+		if !ok {
+			continue
+		}
+
+		if err := w.writer.HighlightCells(report.Name, cells); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sheetRange lays out report as a write range and value range for its tab,
+// plus the cells with an unmet synergy requirement to highlight.
+func sheetRange(report Report) (string, *gsheets.ValueRange, []sheets.CellRef) {
+	header := make([]interface{}, 0, len(report.Teams)+2)
+	header = append(header, "Player")
+	for _, team := range report.Teams {
+		header = append(header, team)
+	}
+	header = append(header, "Average")
+
+	values := make([][]interface{}, len(report.Rows)+1)
+	values[0] = header
+
+	var cells []sheets.CellRef
+
+	for i, row := range report.Rows {
+		record := make([]interface{}, 0, len(header))
+		record = append(record, row.PlayerName)
+
+		for col, team := range report.Teams {
+			record = append(record, row.TeamPowers[team])
+
+			if row.SynergyUnmet[team] {
+				cells = append(cells, sheets.CellRef{Row: i + 1, Col: col + 1})
+			}
+		}
+
+		record = append(record, row.Average)
+
+		values[i+1] = record
+	}
+
+	writeRange := fmt.Sprintf("%s!A1:%s%s", report.Name, sheetCellIndexToLetter[len(header)-1], strconv.Itoa(len(values)))
+	valueRange := &gsheets.ValueRange{MajorDimension: "ROWS", Values: values}
+
+	return writeRange, valueRange, cells
+}
@@ -0,0 +1,117 @@
+// Package report builds alliance power reports from MSF player character
+// data as destination-agnostic output.Reports, ready for any output.Writer
+// to render.
+package report
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/msfpal"
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/output"
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/storage"
+)
+
+// PlayerCharactersByPlayer groups a flat list of player characters by player ID.
+func PlayerCharactersByPlayer(characters msfpal.MSFPlayerCharacters) map[string]msfpal.MSFPlayerCharacters {
+	playerCharactersMap := map[string]msfpal.MSFPlayerCharacters{}
+
+	for _, character := range characters {
+		playerCharactersMap[character.Player] = append(playerCharactersMap[character.Player], character)
+	}
+
+	return playerCharactersMap
+}
+
+// AverageTeamPowerByPlayer computes each player's total power for every team
+// and the player's average across all teams, sorted highest average first.
+func AverageTeamPowerByPlayer(playerCharactersMap map[string]msfpal.MSFPlayerCharacters, teams []msfpal.MSFTeam, name string) output.Report {
+	return teamPowerTable(teamPowerByPlayer(playerCharactersMap, teams), teams, name)
+}
+
+// DeltaTeamPowerByPlayer computes the per-team power gained by each player
+// from a storage.Delta between two pulls, suitable for a "since" report.
+func DeltaTeamPowerByPlayer(delta *storage.Delta, teams []msfpal.MSFTeam, name string) output.Report {
+	playerCharactersMap := make(map[string]msfpal.MSFPlayerCharacters, len(delta.PlayerCharacterPower))
+
+	for player, characterPower := range delta.PlayerCharacterPower {
+		characters := make(msfpal.MSFPlayerCharacters, 0, len(characterPower))
+		for characterID, power := range characterPower {
+			characters = append(characters, msfpal.MSFPlayerCharacter{Player: player, ID: characterID, Power: power})
+		}
+
+		playerCharactersMap[player] = characters
+	}
+
+	return teamPowerTable(teamPowerByPlayer(playerCharactersMap, teams), teams, name)
+}
+
+// teamPowerByPlayer sums each player's character power per team.
+func teamPowerByPlayer(playerCharactersMap map[string]msfpal.MSFPlayerCharacters, teams []msfpal.MSFTeam) map[string][]int {
+	playerTeamsMap := map[string][]int{}
+
+	for _, team := range teams {
+		for player, characters := range playerCharactersMap {
+			if _, ok := playerTeamsMap[player]; !ok {
+				playerTeamsMap[player] = []int{}
+			}
+
+			teamTotalPower := 0
+			for _, teamCharacter := range team.Characters {
+				for _, character := range characters {
+					if strings.ToLower(character.ID) == teamCharacter {
+						teamTotalPower += character.Power
+					}
+				}
+			}
+
+			playerTeamsMap[player] = append(playerTeamsMap[player], teamTotalPower)
+		}
+	}
+
+	return playerTeamsMap
+}
+
+// teamPowerTable lays out a player-by-team power table as an output.Report,
+// with each player's average across all teams, sorted by that average
+// descending.
+func teamPowerTable(playerTeamsMap map[string][]int, teams []msfpal.MSFTeam, name string) output.Report {
+	teamLabels := make([]string, len(teams))
+	for i, team := range teams {
+		teamLabels[i] = team.Label
+	}
+
+	playerKeys := make([]string, 0, len(playerTeamsMap))
+	for player := range playerTeamsMap {
+		playerKeys = append(playerKeys, player)
+	}
+
+	sort.Strings(playerKeys)
+
+	rows := make([]output.Row, 0, len(playerKeys))
+
+	for _, player := range playerKeys {
+		powers := playerTeamsMap[player]
+
+		teamPowers := make(map[string]int, len(teamLabels))
+		totalPower := 0
+
+		for i, power := range powers {
+			if i < len(teamLabels) {
+				teamPowers[teamLabels[i]] = power
+			}
+
+			totalPower += power
+		}
+
+		rows = append(rows, output.Row{
+			PlayerName: player,
+			TeamPowers: teamPowers,
+			Average:    totalPower / len(powers),
+		})
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool { return rows[i].Average > rows[j].Average })
+
+	return output.Report{Name: name, Teams: teamLabels, Rows: rows}
+}
@@ -0,0 +1,65 @@
+package report
+
+import (
+	"testing"
+
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/msfpal"
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/storage"
+)
+
+func TestAverageTeamPowerByPlayer(t *testing.T) {
+	teams := []msfpal.MSFTeam{
+		{Name: "teamA", Label: "Team A", Characters: []string{"char1", "char2"}},
+	}
+
+	playerCharactersMap := map[string]msfpal.MSFPlayerCharacters{
+		"alice": {
+			{Player: "alice", ID: "char1", Power: 100},
+			{Player: "alice", ID: "char2", Power: 200},
+		},
+		"bob": {
+			{Player: "bob", ID: "char1", Power: 10},
+		},
+	}
+
+	got := AverageTeamPowerByPlayer(playerCharactersMap, teams, "Offense")
+
+	if got.Name != "Offense" {
+		t.Errorf("Name = %q, want %q", got.Name, "Offense")
+	}
+
+	if len(got.Rows) != 2 || got.Rows[0].PlayerName != "alice" {
+		t.Fatalf("Rows = %+v, want alice first", got.Rows)
+	}
+
+	if got.Rows[0].TeamPowers["Team A"] != 300 || got.Rows[0].Average != 300 {
+		t.Errorf("alice row = %+v, want power 300 and average 300", got.Rows[0])
+	}
+}
+
+func TestDeltaTeamPowerByPlayer(t *testing.T) {
+	teams := []msfpal.MSFTeam{
+		{Name: "teamA", Label: "Team A", Characters: []string{"char1"}},
+	}
+
+	s, err := storage.New(t.TempDir())
+	if err != nil {
+		t.Fatalf("storage.New() error = %v", err)
+	}
+
+	from, err := s.Save(msfpal.MSFPlayerCharacters{{Player: "alice", ID: "char1", Power: 100}})
+	if err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	to, err := s.Save(msfpal.MSFPlayerCharacters{{Player: "alice", ID: "char1", Power: 150}})
+	if err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	got := DeltaTeamPowerByPlayer(s.Diff(from, to), teams, "Delta")
+
+	if len(got.Rows) != 1 || got.Rows[0].TeamPowers["Team A"] != 50 {
+		t.Fatalf("Rows = %+v, want delta of 50", got.Rows)
+	}
+}
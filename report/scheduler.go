@@ -0,0 +1,109 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/output"
+	"golang.org/x/sync/errgroup"
+)
+
+// GenJob produces one output.Report.
+type GenJob struct {
+	Name string
+	Gen  func(ctx context.Context) (output.Report, error)
+}
+
+// SyncJob adapts a synchronous, in-memory report function — one of the
+// AverageTeamPowerByPlayer-family functions, which cannot fail or be
+// canceled — to a GenJob.
+func SyncJob(name string, gen func() output.Report) GenJob {
+	return GenJob{
+		Name: name,
+		Gen: func(ctx context.Context) (output.Report, error) {
+			return gen(), nil
+		},
+	}
+}
+
+// Scheduler runs GenJobs across a fixed pool of workers, so report
+// generation scales with available CPU instead of running serially.
+type Scheduler struct {
+	workers int
+	timeout time.Duration
+}
+
+// NewScheduler returns a Scheduler with workers concurrent workers, each
+// job bounded by timeout. workers is clamped to at least 1.
+func NewScheduler(workers int, timeout time.Duration) *Scheduler {
+	if workers < 1 {
+		workers = 1
+	}
+
+	return &Scheduler{workers: workers, timeout: timeout}
+}
+
+// Run executes every job, returning results in the same order as jobs. If
+// any job fails, Run stops launching new jobs, waits for in-flight jobs to
+// finish, and returns the first error.
+func (s *Scheduler) Run(ctx context.Context, jobs []GenJob) ([]output.Report, error) {
+	type indexed struct {
+		index int
+		job   GenJob
+	}
+
+	jobCh := make(chan indexed, len(jobs))
+	for i, job := range jobs {
+		jobCh <- indexed{index: i, job: job}
+	}
+	close(jobCh)
+
+	results := make([]output.Report, len(jobs))
+
+	g, gctx := errgroup.WithContext(ctx)
+
+	workers := s.workers
+	if workers > len(jobs) {
+		workers = len(jobs)
+	}
+
+	for w := 0; w < workers; w++ {
+		g.Go(func() error {
+			for ij := range jobCh {
+				if err := s.runJob(gctx, ij.job, &results[ij.index]); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (s *Scheduler) runJob(ctx context.Context, job GenJob, result *output.Report) error {
+	jobCtx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	start := time.Now()
+	log.Printf("report: job %s starting", job.Name)
+
+	report, err := job.Gen(jobCtx)
+	if err != nil {
+		log.Printf("report: job %s failed after %s: %v", job.Name, time.Since(start), err)
+		return fmt.Errorf("job %s: %w", job.Name, err)
+	}
+
+	log.Printf("report: job %s finished in %s", job.Name, time.Since(start))
+
+	*result = report
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/output"
+)
+
+func TestSchedulerRunPreservesOrder(t *testing.T) {
+	s := NewScheduler(4, time.Second)
+
+	jobs := make([]GenJob, 10)
+	for i := range jobs {
+		i := i
+		jobs[i] = GenJob{
+			Name: "job",
+			Gen: func(ctx context.Context) (output.Report, error) {
+				return output.Report{Name: string(rune('a' + i))}, nil
+			},
+		}
+	}
+
+	results, err := s.Run(context.Background(), jobs)
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	for i, result := range results {
+		if want := string(rune('a' + i)); result.Name != want {
+			t.Errorf("results[%d].Name = %q, want %q", i, result.Name, want)
+		}
+	}
+}
+
+func TestSchedulerRunSurfacesJobError(t *testing.T) {
+	s := NewScheduler(2, time.Second)
+
+	wantErr := errors.New("boom")
+
+	jobs := []GenJob{
+		{Name: "ok", Gen: func(ctx context.Context) (output.Report, error) {
+			return output.Report{Name: "ok"}, nil
+		}},
+		{Name: "bad", Gen: func(ctx context.Context) (output.Report, error) {
+			return output.Report{}, wantErr
+		}},
+	}
+
+	_, err := s.Run(context.Background(), jobs)
+	if err == nil {
+		t.Fatal("Run() error = nil, want error from failing job")
+	}
+
+	if !errors.Is(err, wantErr) {
+		t.Errorf("Run() error = %v, want it to wrap %v", err, wantErr)
+	}
+}
@@ -0,0 +1,108 @@
+package report
+
+import (
+	"strings"
+
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/msfpal"
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/output"
+)
+
+// synergyPenalty is applied to a team's total power, per player, when that
+// player's owned characters don't satisfy one of the team's synergy
+// requirements (e.g. not enough characters with a required trait).
+const synergyPenalty = 0.9
+
+// AverageTeamPowerByPlayerWithSynergy is AverageTeamPowerByPlayer, except a
+// team's power for a player is reduced by synergyPenalty whenever that
+// player's roster doesn't meet one of the team's characters' synergy
+// requirements, using Traits and Synergies from catalog. Rows for an unmet
+// synergy have the corresponding team flagged in SynergyUnmet, so a Writer
+// can call it out.
+func AverageTeamPowerByPlayerWithSynergy(playerCharactersMap map[string]msfpal.MSFPlayerCharacters, teams []msfpal.MSFTeam, name string, catalog msfpal.MSFCharacters) output.Report {
+	catalogByID := map[string]msfpal.MSFCharacter{}
+	for _, character := range catalog {
+		catalogByID[strings.ToLower(character.ID)] = character
+	}
+
+	playerTeamsMap := map[string][]int{}
+	playerSynergyUnmet := map[string][]bool{}
+
+	for _, team := range teams {
+		for player, characters := range playerCharactersMap {
+			ownedByID := map[string]msfpal.MSFPlayerCharacter{}
+			for _, character := range characters {
+				ownedByID[strings.ToLower(character.ID)] = character
+			}
+
+			teamTotalPower, unmet := teamPowerAndSynergy(team, ownedByID, catalogByID)
+
+			if unmet {
+				teamTotalPower = int(float64(teamTotalPower) * synergyPenalty)
+			}
+
+			playerTeamsMap[player] = append(playerTeamsMap[player], teamTotalPower)
+			playerSynergyUnmet[player] = append(playerSynergyUnmet[player], unmet)
+		}
+	}
+
+	report := teamPowerTable(playerTeamsMap, teams, name)
+
+	for i := range report.Rows {
+		row := &report.Rows[i]
+
+		for teamIndex, unmet := range playerSynergyUnmet[row.PlayerName] {
+			if !unmet {
+				continue
+			}
+
+			if row.SynergyUnmet == nil {
+				row.SynergyUnmet = map[string]bool{}
+			}
+
+			row.SynergyUnmet[teams[teamIndex].Label] = true
+		}
+	}
+
+	return report
+}
+
+// teamPowerAndSynergy sums a player's owned power for team and reports
+// whether any owned team member's synergy requirement goes unmet, i.e. the
+// team doesn't contain the minimum count of a required trait among the
+// player's owned characters from that team.
+func teamPowerAndSynergy(team msfpal.MSFTeam, ownedByID map[string]msfpal.MSFPlayerCharacter, catalogByID map[string]msfpal.MSFCharacter) (totalPower int, synergyUnmet bool) {
+	traitCounts := map[string]int{}
+
+	var ownedTeamCharacterIDs []string
+
+	for _, characterID := range team.Characters {
+		owned, ok := ownedByID[characterID]
+		if !ok {
+			continue
+		}
+
+		totalPower += owned.Power
+		ownedTeamCharacterIDs = append(ownedTeamCharacterIDs, characterID)
+
+		if catalogCharacter, ok := catalogByID[characterID]; ok {
+			for _, trait := range catalogCharacter.Traits {
+				traitCounts[trait]++
+			}
+		}
+	}
+
+	for _, characterID := range ownedTeamCharacterIDs {
+		catalogCharacter, ok := catalogByID[characterID]
+		if !ok {
+			continue
+		}
+
+		for _, synergy := range catalogCharacter.Synergies {
+			if traitCounts[synergy.Capacity] < synergy.Min {
+				synergyUnmet = true
+			}
+		}
+	}
+
+	return totalPower, synergyUnmet
+}
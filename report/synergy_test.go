@@ -0,0 +1,103 @@
+package report
+
+import (
+	"testing"
+
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/msfpal"
+)
+
+func synergyCatalog() msfpal.MSFCharacters {
+	return msfpal.MSFCharacters{
+		{
+			ID:     "char1",
+			Traits: []string{"trait1"},
+			Synergies: []struct {
+				Capacity string `json:"capacity"`
+				Min      int    `json:"min"`
+			}{
+				{Capacity: "trait1", Min: 2},
+			},
+		},
+		{
+			ID:     "char2",
+			Traits: []string{"trait1"},
+		},
+	}
+}
+
+func TestAverageTeamPowerByPlayerWithSynergyMet(t *testing.T) {
+	teams := []msfpal.MSFTeam{
+		{Name: "teamA", Label: "Team A", Characters: []string{"char1", "char2"}},
+	}
+
+	playerCharactersMap := map[string]msfpal.MSFPlayerCharacters{
+		"alice": {
+			{Player: "alice", ID: "char1", Power: 100},
+			{Player: "alice", ID: "char2", Power: 200},
+		},
+	}
+
+	got := AverageTeamPowerByPlayerWithSynergy(playerCharactersMap, teams, "Offense", synergyCatalog())
+
+	if len(got.Rows) != 1 {
+		t.Fatalf("Rows = %+v, want 1 row", got.Rows)
+	}
+
+	row := got.Rows[0]
+
+	if row.TeamPowers["Team A"] != 300 {
+		t.Errorf("TeamPowers[Team A] = %d, want 300 (no penalty, synergy met)", row.TeamPowers["Team A"])
+	}
+
+	if row.SynergyUnmet["Team A"] {
+		t.Error("SynergyUnmet[Team A] = true, want false for a met synergy")
+	}
+}
+
+func TestAverageTeamPowerByPlayerWithSynergyUnmet(t *testing.T) {
+	teams := []msfpal.MSFTeam{
+		{Name: "teamA", Label: "Team A", Characters: []string{"char1", "char2"}},
+	}
+
+	playerCharactersMap := map[string]msfpal.MSFPlayerCharacters{
+		"alice": {
+			{Player: "alice", ID: "char1", Power: 100},
+		},
+	}
+
+	got := AverageTeamPowerByPlayerWithSynergy(playerCharactersMap, teams, "Offense", synergyCatalog())
+
+	row := got.Rows[0]
+
+	if want := int(100 * synergyPenalty); row.TeamPowers["Team A"] != want {
+		t.Errorf("TeamPowers[Team A] = %d, want %d (penalized for unmet synergy)", row.TeamPowers["Team A"], want)
+	}
+
+	if !row.SynergyUnmet["Team A"] {
+		t.Error("SynergyUnmet[Team A] = false, want true for an unmet synergy")
+	}
+}
+
+func TestAverageTeamPowerByPlayerWithSynergyNoOwnedCharacters(t *testing.T) {
+	teams := []msfpal.MSFTeam{
+		{Name: "teamA", Label: "Team A", Characters: []string{"char1", "char2"}},
+	}
+
+	playerCharactersMap := map[string]msfpal.MSFPlayerCharacters{
+		"alice": {
+			{Player: "alice", ID: "char3", Power: 500},
+		},
+	}
+
+	got := AverageTeamPowerByPlayerWithSynergy(playerCharactersMap, teams, "Offense", synergyCatalog())
+
+	row := got.Rows[0]
+
+	if row.TeamPowers["Team A"] != 0 {
+		t.Errorf("TeamPowers[Team A] = %d, want 0 for no owned team characters", row.TeamPowers["Team A"])
+	}
+
+	if row.SynergyUnmet["Team A"] {
+		t.Error("SynergyUnmet[Team A] = true, want false when the player owns none of the team's characters")
+	}
+}
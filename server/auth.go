@@ -0,0 +1,38 @@
+package server
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+)
+
+// LoadTokens reads one bearer token per regular file in dir, analogous to
+// the credential-per-file layout of the token.json/credentials.json pair.
+// A file's trimmed contents is the token; empty files are skipped.
+func LoadTokens(dir string) (map[string]struct{}, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("server: reading tokens directory %s: %w", dir, err)
+	}
+
+	tokens := map[string]struct{}{}
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		data, err := ioutil.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("server: reading token file %s: %w", entry.Name(), err)
+		}
+
+		token := strings.TrimSpace(string(data))
+		if token != "" {
+			tokens[token] = struct{}{}
+		}
+	}
+
+	return tokens, nil
+}
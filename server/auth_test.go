@@ -0,0 +1,32 @@
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadTokens(t *testing.T) {
+	dir := t.TempDir()
+
+	if err := os.WriteFile(filepath.Join(dir, "discord-bot"), []byte("secret-1\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "empty"), []byte("  \n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tokens, err := LoadTokens(dir)
+	if err != nil {
+		t.Fatalf("LoadTokens() error = %v", err)
+	}
+
+	if _, ok := tokens["secret-1"]; !ok {
+		t.Fatalf("tokens = %v, want %q present", tokens, "secret-1")
+	}
+
+	if len(tokens) != 1 {
+		t.Fatalf("len(tokens) = %d, want 1 (empty file should be skipped)", len(tokens))
+	}
+}
@@ -0,0 +1,188 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/msfpal"
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/output"
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/report"
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/teams"
+)
+
+func (s *Server) handleReports(w http.ResponseWriter, r *http.Request) {
+	kind := reportKindFromPath("/reports", r.URL.Path)
+	if kind == "" {
+		http.Error(w, "report kind is required", http.StatusBadRequest)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		s.handleGetReport(w, kind)
+	case http.MethodPost:
+		s.handlePostReport(w, r, kind)
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *Server) handleGetReport(w http.ResponseWriter, kind string) {
+	cached, ok := s.getCache(kind)
+	if !ok {
+		http.Error(w, fmt.Sprintf("no report generated yet for %q", kind), http.StatusNotFound)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, cached)
+}
+
+func (s *Server) handlePostReport(w http.ResponseWriter, r *http.Request, kind string) {
+	var teamList []msfpal.MSFTeam
+	var sheetName string
+
+	if kind == "custom" {
+		if err := json.NewDecoder(r.Body).Decode(&teamList); err != nil {
+			http.Error(w, fmt.Sprintf("decoding team definitions: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		if err := teams.ValidateTeamList(kind, teamList, s.catalog); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		sheetName = "Custom"
+	} else {
+		categoryTeams, ok := s.teamsStore.Categories()[kind]
+		if !ok {
+			http.Error(w, fmt.Sprintf("unknown report kind %q", kind), http.StatusNotFound)
+			return
+		}
+
+		teamList = categoryTeams
+		sheetName = teams.SheetName(kind)
+	}
+
+	job := s.jobs.create()
+
+	go s.runReportJob(job.ID, kind, teamList, sheetName)
+
+	writeJSON(w, http.StatusAccepted, job)
+}
+
+func (s *Server) runReportJob(jobID, kind string, teamList []msfpal.MSFTeam, sheetName string) {
+	s.jobs.setRunning(jobID)
+
+	playerCharactersMap, err := s.playerCharactersMap(context.Background())
+	if err != nil {
+		s.jobs.setFailed(jobID, err)
+		return
+	}
+
+	result := report.AverageTeamPowerByPlayerWithSynergy(playerCharactersMap, teamList, sheetName, s.catalog)
+
+	if err := s.writer.Write(context.Background(), result); err != nil {
+		s.jobs.setFailed(jobID, err)
+		return
+	}
+
+	s.setCache(kind, result)
+	s.jobs.setDone(jobID)
+}
+
+func (s *Server) handleFullResync(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	job := s.jobs.create()
+
+	go s.runFullResync(job.ID)
+
+	writeJSON(w, http.StatusAccepted, job)
+}
+
+func (s *Server) runFullResync(jobID string) {
+	s.jobs.setRunning(jobID)
+
+	ctx := context.Background()
+
+	characters, err := s.client.AllianceCharacters(ctx, s.allianceID)
+	if err != nil {
+		s.jobs.setFailed(jobID, err)
+		return
+	}
+
+	snapshot, err := s.store.Save(characters)
+	if err != nil {
+		s.jobs.setFailed(jobID, err)
+		return
+	}
+
+	playerCharactersMap := report.PlayerCharactersByPlayer(snapshot.Characters)
+
+	categories := s.teamsStore.Categories()
+
+	kinds := make([]string, 0, len(categories))
+	for kind := range categories {
+		kinds = append(kinds, kind)
+	}
+
+	sort.Strings(kinds)
+
+	jobs := make([]report.GenJob, 0, len(kinds))
+
+	for _, kind := range kinds {
+		categoryTeams := categories[kind]
+		sheetName := teams.SheetName(kind)
+
+		jobs = append(jobs, report.SyncJob(kind, func() output.Report {
+			return report.AverageTeamPowerByPlayerWithSynergy(playerCharactersMap, categoryTeams, sheetName, s.catalog)
+		}))
+	}
+
+	results, err := s.scheduler.Run(ctx, jobs)
+	if err != nil {
+		s.jobs.setFailed(jobID, err)
+		return
+	}
+
+	if err := output.WriteAll(ctx, s.writer, results); err != nil {
+		s.jobs.setFailed(jobID, err)
+		return
+	}
+
+	for i, result := range results {
+		s.setCache(kinds[i], result)
+	}
+
+	s.jobs.setDone(jobID)
+}
+
+func (s *Server) handleJobStatus(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	id := reportKindFromPath("/jobs", r.URL.Path)
+
+	job, ok := s.jobs.get(id)
+	if !ok {
+		http.Error(w, fmt.Sprintf("no job %q", id), http.StatusNotFound)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, job)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,172 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/msfpal"
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/output"
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/report"
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/storage"
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/teams"
+)
+
+// noopWriter discards every report, so handler tests don't need a real
+// output destination.
+type noopWriter struct{}
+
+func (noopWriter) Write(ctx context.Context, report output.Report) error { return nil }
+
+func newTestServer(t *testing.T) *Server {
+	t.Helper()
+
+	msf := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[]`))
+	}))
+	t.Cleanup(msf.Close)
+
+	client := msfpal.NewClient("test-key", msfpal.WithBaseURL(msf.URL))
+
+	store, err := storage.New(t.TempDir())
+	if err != nil {
+		t.Fatalf("storage.New() error = %v", err)
+	}
+
+	teamsPath := writeTestTeamsConfig(t)
+
+	teamsStore, err := teams.NewStore(teamsPath)
+	if err != nil {
+		t.Fatalf("teams.NewStore() error = %v", err)
+	}
+
+	catalog := msfpal.MSFCharacters{{ID: "char1"}, {ID: "char2"}}
+
+	scheduler := report.NewScheduler(2, 0)
+
+	return New(client, store, noopWriter{}, scheduler, "alliance-1", teamsStore, catalog)
+}
+
+func writeTestTeamsConfig(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	path := dir + "/teams.yaml"
+
+	yaml := "categories:\n  offense:\n    - name: testTeam\n      label: Test Team\n      characters: [char1]\n"
+	if err := os.WriteFile(path, []byte(yaml), 0o644); err != nil {
+		t.Fatalf("writing teams config: %v", err)
+	}
+
+	return path
+}
+
+func TestHandleGetReportBeforeAndAfterCache(t *testing.T) {
+	s := newTestServer(t)
+	srv := httptest.NewServer(s.Routes())
+	t.Cleanup(srv.Close)
+
+	resp, err := http.Get(srv.URL + "/reports/offense")
+	if err != nil {
+		t.Fatalf("GET /reports/offense error = %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("GET /reports/offense (uncached) status = %d, want %d", resp.StatusCode, http.StatusNotFound)
+	}
+
+	s.setCache("offense", output.Report{Name: "Offense"})
+
+	resp, err = http.Get(srv.URL + "/reports/offense")
+	if err != nil {
+		t.Fatalf("GET /reports/offense error = %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("GET /reports/offense (cached) status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+
+	var got cachedReport
+	if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+		t.Fatalf("decoding response: %v", err)
+	}
+
+	if got.Report.Name != "Offense" {
+		t.Errorf("Report.Name = %q, want %q", got.Report.Name, "Offense")
+	}
+}
+
+func TestHandlePostReportCustom(t *testing.T) {
+	s := newTestServer(t)
+	srv := httptest.NewServer(s.Routes())
+	t.Cleanup(srv.Close)
+
+	validBody, err := json.Marshal([]msfpal.MSFTeam{
+		{Name: "teamA", Label: "Team A", Characters: []string{"char1"}},
+	})
+	if err != nil {
+		t.Fatalf("marshaling valid body: %v", err)
+	}
+
+	resp, err := http.Post(srv.URL+"/reports/custom", "application/json", bytes.NewReader(validBody))
+	if err != nil {
+		t.Fatalf("POST /reports/custom (valid) error = %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusAccepted {
+		t.Fatalf("POST /reports/custom (valid) status = %d, want %d", resp.StatusCode, http.StatusAccepted)
+	}
+
+	invalidBody, err := json.Marshal([]msfpal.MSFTeam{
+		{Name: "teamA", Label: "Dupe", Characters: []string{"char1"}},
+		{Name: "teamB", Label: "Dupe", Characters: []string{"char1"}},
+	})
+	if err != nil {
+		t.Fatalf("marshaling invalid body: %v", err)
+	}
+
+	resp, err = http.Post(srv.URL+"/reports/custom", "application/json", bytes.NewReader(invalidBody))
+	if err != nil {
+		t.Fatalf("POST /reports/custom (invalid) error = %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Fatalf("POST /reports/custom (duplicate label) status = %d, want %d", resp.StatusCode, http.StatusBadRequest)
+	}
+}
+
+func TestHandleJobStatus(t *testing.T) {
+	s := newTestServer(t)
+	srv := httptest.NewServer(s.Routes())
+	t.Cleanup(srv.Close)
+
+	job := s.jobs.create()
+
+	resp, err := http.Get(srv.URL + "/jobs/" + job.ID)
+	if err != nil {
+		t.Fatalf("GET /jobs/%s error = %v", job.ID, err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("GET /jobs/%s status = %d, want %d", job.ID, resp.StatusCode, http.StatusOK)
+	}
+
+	resp, err = http.Get(srv.URL + "/jobs/does-not-exist")
+	if err != nil {
+		t.Fatalf("GET /jobs/does-not-exist error = %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("GET /jobs/does-not-exist status = %d, want %d", resp.StatusCode, http.StatusNotFound)
+	}
+}
@@ -0,0 +1,108 @@
+package server
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// JobStatus is the lifecycle state of an asynchronous report job.
+type JobStatus string
+
+const (
+	JobStatusPending JobStatus = "pending"
+	JobStatusRunning JobStatus = "running"
+	JobStatusDone    JobStatus = "done"
+	JobStatusFailed  JobStatus = "failed"
+)
+
+// Job tracks the state of one asynchronous request.
+type Job struct {
+	ID         string     `json:"id"`
+	Status     JobStatus  `json:"status"`
+	Error      string     `json:"error,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+}
+
+// jobManager tracks every job the server has created, in memory.
+type jobManager struct {
+	mu     sync.Mutex
+	nextID uint64
+	jobs   map[string]*Job
+}
+
+func newJobManager() *jobManager {
+	return &jobManager{jobs: map[string]*Job{}}
+}
+
+// create adds a new pending job and returns a snapshot of it. The returned
+// *Job is a copy, not the one tracked internally, so a caller that hangs
+// onto it (e.g. to write it out as an HTTP response) never races with the
+// background goroutine that updates the tracked job's status.
+func (m *jobManager) create() *Job {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.nextID++
+
+	job := &Job{
+		ID:        fmt.Sprintf("job-%d", m.nextID),
+		Status:    JobStatusPending,
+		CreatedAt: time.Now(),
+	}
+
+	m.jobs[job.ID] = job
+
+	snapshot := *job
+
+	return &snapshot
+}
+
+// get returns a snapshot of the job with the given ID, for the same reason
+// create does: the caller must not be able to race with later updates.
+func (m *jobManager) get(id string) (*Job, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	job, ok := m.jobs[id]
+	if !ok {
+		return nil, false
+	}
+
+	snapshot := *job
+
+	return &snapshot, true
+}
+
+func (m *jobManager) setRunning(id string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if job, ok := m.jobs[id]; ok {
+		job.Status = JobStatusRunning
+	}
+}
+
+func (m *jobManager) setDone(id string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if job, ok := m.jobs[id]; ok {
+		now := time.Now()
+		job.Status = JobStatusDone
+		job.FinishedAt = &now
+	}
+}
+
+func (m *jobManager) setFailed(id string, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if job, ok := m.jobs[id]; ok {
+		now := time.Now()
+		job.Status = JobStatusFailed
+		job.Error = err.Error()
+		job.FinishedAt = &now
+	}
+}
@@ -0,0 +1,45 @@
+package server
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestJobManagerLifecycle(t *testing.T) {
+	m := newJobManager()
+
+	job := m.create()
+	if job.Status != JobStatusPending {
+		t.Fatalf("new job status = %q, want %q", job.Status, JobStatusPending)
+	}
+
+	m.setRunning(job.ID)
+
+	got, ok := m.get(job.ID)
+	if !ok {
+		t.Fatalf("get(%q) not found", job.ID)
+	}
+
+	if got.Status != JobStatusRunning {
+		t.Fatalf("job status = %q, want %q", got.Status, JobStatusRunning)
+	}
+
+	m.setFailed(job.ID, errors.New("boom"))
+
+	got, _ = m.get(job.ID)
+	if got.Status != JobStatusFailed || got.Error != "boom" {
+		t.Fatalf("job = %+v, want status %q with error %q", got, JobStatusFailed, "boom")
+	}
+
+	if got.FinishedAt == nil {
+		t.Fatal("FinishedAt = nil, want set")
+	}
+}
+
+func TestJobManagerGetMissing(t *testing.T) {
+	m := newJobManager()
+
+	if _, ok := m.get("job-404"); ok {
+		t.Fatal("get() of unknown job returned ok = true")
+	}
+}
@@ -0,0 +1,104 @@
+// Package server turns report-generator into a long-running HTTP service,
+// so a Discord bot or dashboard can trigger report generation on demand
+// instead of relying on a manually run CLI.
+package server
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/msfpal"
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/output"
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/report"
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/storage"
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/teams"
+)
+
+// cachedReport is the last report generated for a given kind, kept in
+// memory so GET /reports/{kind} can be served without regenerating it.
+type cachedReport struct {
+	Report      output.Report `json:"report"`
+	GeneratedAt time.Time     `json:"generatedAt"`
+}
+
+// Server generates MSF alliance reports on demand over HTTP.
+type Server struct {
+	client     *msfpal.Client
+	store      *storage.Storage
+	writer     output.Writer
+	scheduler  *report.Scheduler
+	allianceID string
+	teamsStore *teams.Store
+	catalog    msfpal.MSFCharacters
+
+	jobs *jobManager
+
+	mu    sync.RWMutex
+	cache map[string]cachedReport
+}
+
+// New returns a Server that pulls alliance data with client, persists
+// snapshots in store, writes reports with writer, generates full resyncs
+// concurrently with scheduler, and scores team power using teamsStore's
+// categories and catalog's traits and synergies.
+func New(client *msfpal.Client, store *storage.Storage, writer output.Writer, scheduler *report.Scheduler, allianceID string, teamsStore *teams.Store, catalog msfpal.MSFCharacters) *Server {
+	return &Server{
+		client:     client,
+		store:      store,
+		writer:     writer,
+		scheduler:  scheduler,
+		allianceID: allianceID,
+		teamsStore: teamsStore,
+		catalog:    catalog,
+		jobs:       newJobManager(),
+		cache:      map[string]cachedReport{},
+	}
+}
+
+// Routes returns the server's HTTP handler.
+func (s *Server) Routes() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/reports/", s.handleReports)
+	mux.HandleFunc("/full-resync", s.handleFullResync)
+	mux.HandleFunc("/jobs/", s.handleJobStatus)
+
+	return mux
+}
+
+func (s *Server) setCache(kind string, report output.Report) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.cache[kind] = cachedReport{
+		Report:      report,
+		GeneratedAt: time.Now(),
+	}
+}
+
+func (s *Server) getCache(kind string) (cachedReport, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	cached, ok := s.cache[kind]
+
+	return cached, ok
+}
+
+// playerCharactersMap pulls the alliance's current character data and
+// groups it by player.
+func (s *Server) playerCharactersMap(ctx context.Context) (map[string]msfpal.MSFPlayerCharacters, error) {
+	characters, err := s.client.AllianceCharacters(ctx, s.allianceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return report.PlayerCharactersByPlayer(characters), nil
+}
+
+func reportKindFromPath(prefix, path string) string {
+	return strings.Trim(strings.TrimPrefix(path, prefix), "/")
+}
@@ -0,0 +1,77 @@
+package sheets
+
+import (
+	"fmt"
+
+	gsheets "google.golang.org/api/sheets/v4"
+)
+
+// CellRef is a zero-based row/column position within a single sheet tab.
+type CellRef struct {
+	Row int
+	Col int
+}
+
+// unmetSynergyColor is the background color applied to cells flagged by
+// HighlightCells — a soft red.
+var unmetSynergyColor = &gsheets.Color{Red: 0.96, Green: 0.78, Blue: 0.78}
+
+// HighlightCells sets the background color of each cell in cells to
+// unmetSynergyColor, within the tab named sheetName.
+func (w *Writer) HighlightCells(sheetName string, cells []CellRef) error {
+	if len(cells) == 0 {
+		return nil
+	}
+
+	srv, err := w.sheetsService()
+	if err != nil {
+		return fmt.Errorf("sheets: getting service: %w", err)
+	}
+
+	sheetID, err := w.sheetID(srv, sheetName)
+	if err != nil {
+		return err
+	}
+
+	requests := make([]*gsheets.Request, len(cells))
+	for i, cell := range cells {
+		requests[i] = &gsheets.Request{
+			RepeatCell: &gsheets.RepeatCellRequest{
+				Range: &gsheets.GridRange{
+					SheetId:          sheetID,
+					StartRowIndex:    int64(cell.Row),
+					EndRowIndex:      int64(cell.Row + 1),
+					StartColumnIndex: int64(cell.Col),
+					EndColumnIndex:   int64(cell.Col + 1),
+				},
+				Cell: &gsheets.CellData{
+					UserEnteredFormat: &gsheets.CellFormat{BackgroundColor: unmetSynergyColor},
+				},
+				Fields: "userEnteredFormat.backgroundColor",
+			},
+		}
+	}
+
+	req := &gsheets.BatchUpdateSpreadsheetRequest{Requests: requests}
+
+	if _, err := srv.Spreadsheets.BatchUpdate(w.spreadsheetID, req).Do(); err != nil {
+		return fmt.Errorf("sheets: highlighting %d cells in %q: %w", len(cells), sheetName, err)
+	}
+
+	return nil
+}
+
+func (w *Writer) sheetID(srv *gsheets.Service, sheetName string) (int64, error) {
+	spreadsheet, err := srv.Spreadsheets.Get(w.spreadsheetID).Do()
+	if err != nil {
+		return 0, fmt.Errorf("sheets: getting spreadsheet: %w", err)
+	}
+
+	for _, sheet := range spreadsheet.Sheets {
+		if sheet.Properties.Title == sheetName {
+			return sheet.Properties.SheetId, nil
+		}
+	}
+
+	return 0, fmt.Errorf("sheets: no tab named %q", sheetName)
+}
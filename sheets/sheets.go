@@ -0,0 +1,173 @@
+// Package sheets wraps the Google Sheets API client, handling OAuth token
+// management and writing report ranges to a spreadsheet.
+package sheets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+	gsheets "google.golang.org/api/sheets/v4"
+)
+
+// Writer writes report ranges to a single Google Sheets spreadsheet.
+type Writer struct {
+	spreadsheetID   string
+	credentialsPath string
+	tokenPath       string
+
+	service *gsheets.Service
+}
+
+// NewWriter returns a Writer for the given spreadsheet, reading OAuth client
+// credentials from credentialsPath and caching the resulting token at tokenPath.
+func NewWriter(spreadsheetID, credentialsPath, tokenPath string) *Writer {
+	return &Writer{
+		spreadsheetID:   spreadsheetID,
+		credentialsPath: credentialsPath,
+		tokenPath:       tokenPath,
+	}
+}
+
+// Update writes valueRange to writeRange in the spreadsheet.
+func (w *Writer) Update(writeRange string, valueRange *gsheets.ValueRange) error {
+	srv, err := w.sheetsService()
+	if err != nil {
+		return fmt.Errorf("sheets: getting service: %w", err)
+	}
+
+	if _, err := srv.Spreadsheets.Values.Update(w.spreadsheetID, writeRange, valueRange).ValueInputOption("RAW").Do(); err != nil {
+		return fmt.Errorf("sheets: updating range %q: %w", writeRange, err)
+	}
+
+	return nil
+}
+
+// BatchItem is one write range and its values, for use with BatchUpdate.
+type BatchItem struct {
+	WriteRange string
+	ValueRange *gsheets.ValueRange
+}
+
+// BatchUpdate writes every item to the spreadsheet in a single API call,
+// instead of one Update call per item.
+func (w *Writer) BatchUpdate(items []BatchItem) error {
+	srv, err := w.sheetsService()
+	if err != nil {
+		return fmt.Errorf("sheets: getting service: %w", err)
+	}
+
+	data := make([]*gsheets.ValueRange, len(items))
+	for i, item := range items {
+		item.ValueRange.Range = item.WriteRange
+		data[i] = item.ValueRange
+	}
+
+	req := &gsheets.BatchUpdateValuesRequest{
+		ValueInputOption: "RAW",
+		Data:             data,
+	}
+
+	if _, err := srv.Spreadsheets.Values.BatchUpdate(w.spreadsheetID, req).Do(); err != nil {
+		return fmt.Errorf("sheets: batch updating %d ranges: %w", len(items), err)
+	}
+
+	return nil
+}
+
+func (w *Writer) sheetsService() (*gsheets.Service, error) {
+	if w.service != nil {
+		return w.service, nil
+	}
+
+	credentialsFile, err := ioutil.ReadFile(w.credentialsPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read client secret file: %w", err)
+	}
+
+	// If modifying these scopes, delete the previously saved token file.
+	config, err := google.ConfigFromJSON(credentialsFile, "https://www.googleapis.com/auth/spreadsheets")
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse client secret file to config: %w", err)
+	}
+
+	httpClient := w.client(config)
+
+	service, err := gsheets.New(httpClient)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve Sheets client: %w", err)
+	}
+
+	w.service = service
+
+	return service, nil
+}
+
+// client retrieves a token, saving it to w.tokenPath, then returns the
+// generated http.Client.
+func (w *Writer) client(config *oauth2.Config) *http.Client {
+	tok, err := tokenFromFile(w.tokenPath)
+	if err != nil {
+		tok = getTokenFromWeb(config)
+		saveToken(w.tokenPath, tok)
+	}
+
+	return config.Client(context.Background(), tok)
+}
+
+// saveToken saves a token to a file path.
+func saveToken(path string, token *oauth2.Token) {
+	fmt.Printf("Saving credential file to: %s\n", path)
+
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		fmt.Printf("Unable to cache oauth token: %v\n", err)
+		return
+	}
+
+	defer f.Close()
+
+	json.NewEncoder(f).Encode(token)
+}
+
+// getTokenFromWeb requests a token from the web, then returns the retrieved token.
+func getTokenFromWeb(config *oauth2.Config) *oauth2.Token {
+	authURL := config.AuthCodeURL("state-token", oauth2.AccessTypeOffline)
+
+	fmt.Printf("Go to the following link in your browser then type the "+
+		"authorization code: \n%v\n", authURL)
+
+	var authCode string
+	if _, err := fmt.Scan(&authCode); err != nil {
+		fmt.Printf("Unable to read authorization code: %v\n", err)
+		os.Exit(1)
+	}
+
+	tok, err := config.Exchange(context.TODO(), authCode)
+	if err != nil {
+		fmt.Printf("Unable to retrieve token from web: %v\n", err)
+		os.Exit(1)
+	}
+
+	return tok
+}
+
+// tokenFromFile retrieves a token from a local file.
+func tokenFromFile(file string) (*oauth2.Token, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	tok := &oauth2.Token{}
+	err = json.NewDecoder(f).Decode(tok)
+
+	return tok, err
+}
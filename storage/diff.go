@@ -0,0 +1,38 @@
+package storage
+
+// Delta is the per-player, per-character power change between two snapshots.
+type Delta struct {
+	From                 *Snapshot
+	To                   *Snapshot
+	PlayerCharacterPower map[string]map[string]int
+}
+
+// Diff returns the power gained (or lost) per player per character between
+// snapshot a and snapshot b. Characters present in b but not a are counted
+// as gaining their full power; characters present in a but not b are
+// counted as losing their full power.
+func (s *Storage) Diff(a, b *Snapshot) *Delta {
+	before := map[string]map[string]int{}
+	for _, c := range a.Characters {
+		if before[c.Player] == nil {
+			before[c.Player] = map[string]int{}
+		}
+		before[c.Player][c.ID] = c.Power
+	}
+
+	delta := &Delta{
+		From:                 a,
+		To:                   b,
+		PlayerCharacterPower: map[string]map[string]int{},
+	}
+
+	for _, c := range b.Characters {
+		if delta.PlayerCharacterPower[c.Player] == nil {
+			delta.PlayerCharacterPower[c.Player] = map[string]int{}
+		}
+
+		delta.PlayerCharacterPower[c.Player][c.ID] = c.Power - before[c.Player][c.ID]
+	}
+
+	return delta
+}
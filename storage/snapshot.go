@@ -0,0 +1,41 @@
+// Package storage persists alliance character pulls to disk so reports can
+// be generated from a point-in-time snapshot instead of a live API call, and
+// so two snapshots can be diffed to show power gained over time.
+package storage
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/msfpal"
+)
+
+// Snapshot is a single point-in-time pull of an alliance's character data.
+type Snapshot struct {
+	ID         string                     `json:"id"`
+	Timestamp  time.Time                  `json:"timestamp"`
+	Characters msfpal.MSFPlayerCharacters `json:"characters"`
+}
+
+// snapshotID computes a stable digest of a snapshot's contents, so that two
+// pulls with identical character IDs and power values produce the same ID
+// regardless of when they were taken.
+func snapshotID(characters msfpal.MSFPlayerCharacters) string {
+	keys := make([]string, len(characters))
+	for i, c := range characters {
+		keys[i] = fmt.Sprintf("%s:%s:%d", c.Player, c.ID, c.Power)
+	}
+
+	sort.Strings(keys)
+
+	h := sha1.New()
+	for _, k := range keys {
+		h.Write([]byte(k))
+		h.Write([]byte{'\n'})
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,125 @@
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/msfpal"
+)
+
+// Storage persists alliance snapshots as one JSON file per pull in a
+// directory on disk.
+type Storage struct {
+	dir string
+}
+
+// New returns a Storage backed by dir, creating it if it does not exist.
+func New(dir string) (*Storage, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("storage: creating %s: %w", dir, err)
+	}
+
+	return &Storage{dir: dir}, nil
+}
+
+// Save persists characters as a new snapshot and returns it.
+func (s *Storage) Save(characters msfpal.MSFPlayerCharacters) (*Snapshot, error) {
+	snapshot := &Snapshot{
+		ID:         snapshotID(characters),
+		Timestamp:  time.Now().UTC(),
+		Characters: characters,
+	}
+
+	data, err := json.MarshalIndent(snapshot, "", "\t")
+	if err != nil {
+		return nil, fmt.Errorf("storage: encoding snapshot: %w", err)
+	}
+
+	path := filepath.Join(s.dir, fmt.Sprintf("%d.json", snapshot.Timestamp.UnixNano()))
+	if err := ioutil.WriteFile(path, data, 0o644); err != nil {
+		return nil, fmt.Errorf("storage: writing %s: %w", path, err)
+	}
+
+	return snapshot, nil
+}
+
+// ListSnapshots returns every stored snapshot, ordered oldest first.
+func (s *Storage) ListSnapshots() ([]*Snapshot, error) {
+	entries, err := ioutil.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("storage: reading %s: %w", s.dir, err)
+	}
+
+	var snapshots []*Snapshot
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+
+		data, err := ioutil.ReadFile(filepath.Join(s.dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("storage: reading %s: %w", entry.Name(), err)
+		}
+
+		var snapshot Snapshot
+		if err := json.Unmarshal(data, &snapshot); err != nil {
+			return nil, fmt.Errorf("storage: decoding %s: %w", entry.Name(), err)
+		}
+
+		snapshots = append(snapshots, &snapshot)
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].Timestamp.Before(snapshots[j].Timestamp)
+	})
+
+	return snapshots, nil
+}
+
+// LatestSnapshot returns the most recently taken snapshot.
+func (s *Storage) LatestSnapshot() (*Snapshot, error) {
+	snapshots, err := s.ListSnapshots()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(snapshots) == 0 {
+		return nil, fmt.Errorf("storage: no snapshots in %s", s.dir)
+	}
+
+	return snapshots[len(snapshots)-1], nil
+}
+
+// Find returns the snapshot whose ID has the given prefix, or whose
+// timestamp matches ref when parsed as RFC 3339.
+func (s *Storage) Find(ref string) (*Snapshot, error) {
+	snapshots, err := s.ListSnapshots()
+	if err != nil {
+		return nil, err
+	}
+
+	if ts, err := time.Parse(time.RFC3339, ref); err == nil {
+		for _, snapshot := range snapshots {
+			if snapshot.Timestamp.Equal(ts) {
+				return snapshot, nil
+			}
+		}
+
+		return nil, fmt.Errorf("storage: no snapshot taken at %s", ref)
+	}
+
+	for _, snapshot := range snapshots {
+		if strings.HasPrefix(snapshot.ID, ref) {
+			return snapshot, nil
+		}
+	}
+
+	return nil, fmt.Errorf("storage: no snapshot matching %q", ref)
+}
@@ -0,0 +1,62 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/msfpal"
+)
+
+func TestSaveAndListSnapshots(t *testing.T) {
+	s, err := New(t.TempDir())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	characters := msfpal.MSFPlayerCharacters{{ID: "thanos", Player: "p1", Power: 100}}
+
+	saved, err := s.Save(characters)
+	if err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	latest, err := s.LatestSnapshot()
+	if err != nil {
+		t.Fatalf("LatestSnapshot() error = %v", err)
+	}
+
+	if latest.ID != saved.ID {
+		t.Fatalf("LatestSnapshot().ID = %q, want %q", latest.ID, saved.ID)
+	}
+
+	found, err := s.Find(saved.ID)
+	if err != nil {
+		t.Fatalf("Find() error = %v", err)
+	}
+
+	if found.ID != saved.ID {
+		t.Fatalf("Find().ID = %q, want %q", found.ID, saved.ID)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	s, err := New(t.TempDir())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	before, err := s.Save(msfpal.MSFPlayerCharacters{{ID: "thanos", Player: "p1", Power: 100}})
+	if err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	after, err := s.Save(msfpal.MSFPlayerCharacters{{ID: "thanos", Player: "p1", Power: 150}})
+	if err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	delta := s.Diff(before, after)
+
+	if got := delta.PlayerCharacterPower["p1"]["thanos"]; got != 50 {
+		t.Fatalf("PlayerCharacterPower[p1][thanos] = %d, want 50", got)
+	}
+}
@@ -0,0 +1,145 @@
+package teams
+
+import (
+	"context"
+	"log"
+	"path/filepath"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/msfpal"
+)
+
+// Store holds the current teams Config in memory and can reload it from
+// disk, either on demand or automatically via Watch.
+type Store struct {
+	path string
+
+	mu      sync.RWMutex
+	cfg     *Config
+	catalog msfpal.MSFCharacters
+}
+
+// NewStore loads path and returns a Store backed by it.
+func NewStore(path string) (*Store, error) {
+	cfg, err := Load(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Store{path: path, cfg: cfg}, nil
+}
+
+// SetCatalog sets the character catalog used to validate teams on reload.
+// Without a catalog, reloads are not validated.
+func (s *Store) SetCatalog(catalog msfpal.MSFCharacters) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.catalog = catalog
+}
+
+// Categories returns the teams for every report category.
+func (s *Store) Categories() map[string][]msfpal.MSFTeam {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.cfg.Categories
+}
+
+// Teams returns the teams for a single report category.
+func (s *Store) Teams(category string) []msfpal.MSFTeam {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.cfg.Categories[category]
+}
+
+// AllTeams returns every team across every category.
+func (s *Store) AllTeams() []msfpal.MSFTeam {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.cfg.AllTeams()
+}
+
+// Validate checks the current config's teams against catalog.
+func (s *Store) Validate(catalog msfpal.MSFCharacters) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.cfg.Validate(catalog)
+}
+
+// reload re-reads the config file, validating against the current catalog
+// if one has been set, and swaps it in only if both succeed.
+func (s *Store) reload() error {
+	cfg, err := Load(s.path)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.catalog != nil {
+		if err := cfg.Validate(s.catalog); err != nil {
+			return err
+		}
+	}
+
+	s.cfg = cfg
+
+	return nil
+}
+
+// Watch reloads the config file whenever it changes on disk, until ctx is
+// canceled. Reload failures are logged and leave the last-good config in
+// place.
+func (s *Store) Watch(ctx context.Context) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+
+	if err := watcher.Add(filepath.Dir(s.path)); err != nil {
+		return err
+	}
+
+	name := filepath.Base(s.path)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+
+			if filepath.Base(event.Name) != name {
+				continue
+			}
+
+			if event.Op&(fsnotify.Write|fsnotify.Create) == 0 {
+				continue
+			}
+
+			if err := s.reload(); err != nil {
+				log.Printf("teams: reload of %s failed, keeping previous config: %v", s.path, err)
+				continue
+			}
+
+			log.Printf("teams: reloaded %s", s.path)
+
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+
+			log.Printf("teams: watch error: %v", err)
+		}
+	}
+}
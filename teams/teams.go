@@ -0,0 +1,143 @@
+// Package teams loads war/raid team rosters from a YAML file, so adding or
+// changing a team is a config change instead of a code change, and watches
+// that file for changes so a running server can pick up edits without a
+// restart.
+package teams
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/msfpal"
+	"gopkg.in/yaml.v3"
+)
+
+// Config is the set of teams for every report category, keyed by category
+// name (e.g. "offense", "defense", "flex", "u7").
+type Config struct {
+	Categories map[string][]msfpal.MSFTeam `yaml:"categories"`
+}
+
+// Load reads and parses a teams config file.
+func Load(path string) (*Config, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("teams: reading %s: %w", path, err)
+	}
+
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("teams: parsing %s: %w", path, err)
+	}
+
+	return &cfg, nil
+}
+
+// Validate checks that every character referenced by a team exists in the
+// given catalog and that no two teams share a label — report.teamPowerTable
+// and report.DeltaTeamPowerByPlayer key a row's team powers by label, so a
+// collision would silently drop one team's data. Labels are checked across
+// every category at once (via AllTeams), not just within one, since
+// AllTeams is what a Delta or other cross-category report is built from.
+// Validate returns an error listing every problem found.
+func (c *Config) Validate(catalog msfpal.MSFCharacters) error {
+	known := knownCharacterIDs(catalog)
+
+	var problems []string
+
+	for category, teamList := range c.Categories {
+		problems = append(problems, unknownCharacterIDProblems(category, teamList, known)...)
+	}
+
+	problems = append(problems, duplicateLabelProblems(c.AllTeams())...)
+
+	if len(problems) > 0 {
+		return fmt.Errorf("teams: %s", strings.Join(problems, ", "))
+	}
+
+	return nil
+}
+
+// ValidateTeamList checks teamList the same way Config.Validate checks one
+// category: every referenced character must exist in catalog, and no two
+// teams may share a label. It's exported for ad-hoc team lists that don't
+// live in a Config, such as a user-submitted "custom" report request.
+func ValidateTeamList(category string, teamList []msfpal.MSFTeam, catalog msfpal.MSFCharacters) error {
+	problems := unknownCharacterIDProblems(category, teamList, knownCharacterIDs(catalog))
+	problems = append(problems, duplicateLabelProblems(teamList)...)
+
+	if len(problems) > 0 {
+		return fmt.Errorf("teams: %s", strings.Join(problems, ", "))
+	}
+
+	return nil
+}
+
+func knownCharacterIDs(catalog msfpal.MSFCharacters) map[string]bool {
+	known := map[string]bool{}
+	for _, character := range catalog {
+		known[strings.ToLower(character.ID)] = true
+	}
+
+	return known
+}
+
+func unknownCharacterIDProblems(category string, teamList []msfpal.MSFTeam, known map[string]bool) []string {
+	var problems []string
+
+	for _, team := range teamList {
+		for _, characterID := range team.Characters {
+			if !known[strings.ToLower(characterID)] {
+				problems = append(problems, fmt.Sprintf("%s/%s: unknown character ID %s", category, team.Name, characterID))
+			}
+		}
+	}
+
+	return problems
+}
+
+func duplicateLabelProblems(teamList []msfpal.MSFTeam) []string {
+	var problems []string
+
+	seenLabels := map[string]bool{}
+
+	for _, team := range teamList {
+		if seenLabels[team.Label] {
+			problems = append(problems, fmt.Sprintf("%s: duplicate team label %q", team.Name, team.Label))
+		}
+
+		seenLabels[team.Label] = true
+	}
+
+	return problems
+}
+
+// SheetName returns the spreadsheet tab name for a category, e.g. "offense"
+// becomes "Offense".
+func SheetName(category string) string {
+	if category == "" {
+		return category
+	}
+
+	return strings.ToUpper(category[:1]) + category[1:]
+}
+
+// AllTeams returns every team across every category, for reports that
+// aren't scoped to a single category, such as a delta report.
+func (c *Config) AllTeams() []msfpal.MSFTeam {
+	categories := make([]string, 0, len(c.Categories))
+	for category := range c.Categories {
+		categories = append(categories, category)
+	}
+
+	sort.Strings(categories)
+
+	var all []msfpal.MSFTeam
+	for _, category := range categories {
+		all = append(all, c.Categories[category]...)
+	}
+
+	return all
+}
@@ -0,0 +1,142 @@
+package teams
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jarrodspurrier/msf-pal-alliance-report-generator/msfpal"
+)
+
+const testConfigYAML = `
+categories:
+  offense:
+    - name: testTeam
+      label: Test Team
+      characters: [char1, char2]
+  defense:
+    - name: otherTeam
+      label: Other Team
+      characters: [char3]
+`
+
+func writeTestConfig(t *testing.T) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "teams.yaml")
+	if err := os.WriteFile(path, []byte(testConfigYAML), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	cfg, err := Load(writeTestConfig(t))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	if got, want := len(cfg.Categories["offense"]), 1; got != want {
+		t.Fatalf("len(Categories[offense]) = %d, want %d", got, want)
+	}
+
+	if got, want := cfg.Categories["offense"][0].Label, "Test Team"; got != want {
+		t.Errorf("Categories[offense][0].Label = %q, want %q", got, want)
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	cfg, err := Load(writeTestConfig(t))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	catalog := msfpal.MSFCharacters{
+		{ID: "char1"}, {ID: "char2"}, {ID: "char3"},
+	}
+
+	if err := cfg.Validate(catalog); err != nil {
+		t.Errorf("Validate() error = %v, want nil", err)
+	}
+
+	if err := cfg.Validate(msfpal.MSFCharacters{{ID: "char1"}}); err == nil {
+		t.Error("Validate() error = nil, want error for missing characters")
+	}
+}
+
+func TestConfigValidateDuplicateLabel(t *testing.T) {
+	cfg := &Config{
+		Categories: map[string][]msfpal.MSFTeam{
+			"offense": {
+				{Name: "teamA", Label: "Dupe", Characters: []string{"char1"}},
+				{Name: "teamB", Label: "Dupe", Characters: []string{"char1"}},
+			},
+		},
+	}
+
+	catalog := msfpal.MSFCharacters{{ID: "char1"}}
+
+	if err := cfg.Validate(catalog); err == nil {
+		t.Error("Validate() error = nil, want error for duplicate team label")
+	}
+}
+
+func TestConfigValidateDuplicateLabelAcrossCategories(t *testing.T) {
+	cfg := &Config{
+		Categories: map[string][]msfpal.MSFTeam{
+			"offense": {{Name: "teamA", Label: "Dupe", Characters: []string{"char1"}}},
+			"defense": {{Name: "teamB", Label: "Dupe", Characters: []string{"char1"}}},
+		},
+	}
+
+	catalog := msfpal.MSFCharacters{{ID: "char1"}}
+
+	if err := cfg.Validate(catalog); err == nil {
+		t.Error("Validate() error = nil, want error for label shared across categories")
+	}
+}
+
+func TestValidateTeamList(t *testing.T) {
+	catalog := msfpal.MSFCharacters{{ID: "char1"}}
+
+	teamList := []msfpal.MSFTeam{
+		{Name: "teamA", Label: "Dupe", Characters: []string{"char1"}},
+		{Name: "teamB", Label: "Dupe", Characters: []string{"char1"}},
+	}
+
+	if err := ValidateTeamList("custom", teamList, catalog); err == nil {
+		t.Error("ValidateTeamList() error = nil, want error for duplicate team label")
+	}
+}
+
+func TestConfigAllTeams(t *testing.T) {
+	cfg, err := Load(writeTestConfig(t))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	all := cfg.AllTeams()
+	if got, want := len(all), 2; got != want {
+		t.Fatalf("len(AllTeams()) = %d, want %d", got, want)
+	}
+
+	// defense sorts before offense, so otherTeam should come first.
+	if got, want := all[0].Name, "otherTeam"; got != want {
+		t.Errorf("AllTeams()[0].Name = %q, want %q", got, want)
+	}
+}
+
+func TestSheetName(t *testing.T) {
+	tests := map[string]string{
+		"offense": "Offense",
+		"u7":      "U7",
+		"":        "",
+	}
+
+	for category, want := range tests {
+		if got := SheetName(category); got != want {
+			t.Errorf("SheetName(%q) = %q, want %q", category, got, want)
+		}
+	}
+}